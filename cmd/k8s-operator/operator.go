@@ -8,6 +8,7 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"net/http"
@@ -676,7 +677,7 @@ func runReconcilers(opts reconcilerOpts) {
 
 	// ProxyGroup reconciler.
 	ownedByProxyGroupFilter := handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &tsapi.ProxyGroup{})
-	proxyClassFilterForProxyGroup := handler.EnqueueRequestsFromMapFunc(proxyClassHandlerForProxyGroup(mgr.GetClient(), startlog))
+	proxyClassFilterForProxyGroup := handler.EnqueueRequestsFromMapFunc(proxyClassHandlerForProxyGroup(mgr.GetClient(), opts.defaultProxyClass, startlog))
 	nodeFilterForProxyGroup := handler.EnqueueRequestsFromMapFunc(nodeHandlerForProxyGroup(mgr.GetClient(), opts.defaultProxyClass, startlog))
 	saFilterForProxyGroup := handler.EnqueueRequestsFromMapFunc(serviceAccountHandlerForProxyGroup(mgr.GetClient(), startlog))
 	err = builder.ControllerManagedBy(mgr).
@@ -1055,8 +1056,9 @@ func nodeHandlerForProxyGroup(cl client.Client, defaultProxyClass string, logger
 
 // proxyClassHandlerForProxyGroup returns a handler that, for a given ProxyClass,
 // returns a list of reconcile requests for all ProxyGroups that have
-// .spec.proxyClass set to that ProxyClass.
-func proxyClassHandlerForProxyGroup(cl client.Client, logger *zap.SugaredLogger) handler.MapFunc {
+// .spec.proxyClass set to that ProxyClass, or that have no .spec.proxyClass
+// set if the ProxyClass is the default ProxyClass.
+func proxyClassHandlerForProxyGroup(cl client.Client, defaultProxyClass string, logger *zap.SugaredLogger) handler.MapFunc {
 	return func(ctx context.Context, o client.Object) []reconcile.Request {
 		pgList := new(tsapi.ProxyGroupList)
 		if err := cl.List(ctx, pgList); err != nil {
@@ -1066,7 +1068,8 @@ func proxyClassHandlerForProxyGroup(cl client.Client, logger *zap.SugaredLogger)
 		reqs := make([]reconcile.Request, 0)
 		proxyClassName := o.GetName()
 		for _, pg := range pgList.Items {
-			if pg.Spec.ProxyClass == proxyClassName {
+			pc := cmp.Or(pg.Spec.ProxyClass, defaultProxyClass)
+			if pc == proxyClassName {
 				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pg)})
 			}
 		}
@@ -26,6 +26,7 @@
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"tailscale.com/client/tailscale"
 	"tailscale.com/ipn"
 	kube "tailscale.com/k8s-operator"
@@ -1021,6 +1022,78 @@ func TestProxyGroup(t *testing.T) {
 	})
 }
 
+func TestProxyGroupProxyClassNotFound(t *testing.T) {
+	pg := &tsapi.ProxyGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test",
+			Finalizers: []string{"tailscale.com/finalizer"},
+			Generation: 1,
+		},
+		Spec: tsapi.ProxyGroupSpec{
+			Type:       tsapi.ProxyGroupTypeEgress,
+			ProxyClass: "custom-pc",
+		},
+	}
+	pgDefault := &tsapi.ProxyGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-default",
+			Finalizers: []string{"tailscale.com/finalizer"},
+			Generation: 1,
+		},
+		Spec: tsapi.ProxyGroupSpec{
+			Type: tsapi.ProxyGroupTypeEgress,
+		},
+	}
+	fc := fake.NewClientBuilder().
+		WithScheme(tsapi.GlobalScheme).
+		WithObjects(pg, pgDefault).
+		WithStatusSubresource(pg, pgDefault).
+		Build()
+	zl, _ := zap.NewDevelopment()
+	cl := tstest.NewClock(tstest.ClockOpts{})
+	reconciler := &ProxyGroupReconciler{
+		tsNamespace:       tsNamespace,
+		tsProxyImage:      testProxyImage,
+		defaultTags:       []string{"tag:test-tag"},
+		tsFirewallMode:    "auto",
+		defaultProxyClass: "default-pc",
+
+		Client:   fc,
+		tsClient: &fakeTSClient{},
+		recorder: record.NewFakeRecorder(10),
+		log:      zl.Sugar(),
+		clock:    cl,
+	}
+
+	for _, tc := range []struct {
+		pg *tsapi.ProxyGroup
+		pc string
+	}{
+		{pg: pg, pc: "custom-pc"},
+		{pg: pgDefault, pc: "default-pc"},
+	} {
+		expectReconciled(t, reconciler, "", tc.pg.Name)
+
+		tsoperator.SetProxyGroupCondition(tc.pg, tsapi.ProxyGroupAvailable, metav1.ConditionFalse, reasonProxyGroupCreating, "0/2 ProxyGroup pods running", 0, cl, zl.Sugar())
+		tsoperator.SetProxyGroupCondition(tc.pg, tsapi.ProxyGroupReady, metav1.ConditionFalse, reasonProxyClassNotFound, fmt.Sprintf("the ProxyGroup's ProxyClass %q does not (yet) exist", tc.pc), 1, cl, zl.Sugar())
+		expectEqual(t, fc, tc.pg)
+		expectMissing[appsv1.StatefulSet](t, fc, tsNamespace, tc.pg.Name)
+
+		// Creating the ProxyClass should trigger a reconcile of the ProxyGroup.
+		pc := &tsapi.ProxyClass{ObjectMeta: metav1.ObjectMeta{Name: tc.pc}}
+		mustCreate(t, fc, pc)
+		reqs := proxyClassHandlerForProxyGroup(fc, reconciler.defaultProxyClass, zl.Sugar())(t.Context(), pc)
+		want := []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(tc.pg)}}
+		if diff := cmp.Diff(want, reqs); diff != "" {
+			t.Fatalf("unexpected reconcile requests for ProxyClass %q (-want +got):\n%s", tc.pc, diff)
+		}
+
+		expectReconciled(t, reconciler, "", tc.pg.Name)
+		tsoperator.SetProxyGroupCondition(tc.pg, tsapi.ProxyGroupReady, metav1.ConditionFalse, reasonProxyGroupCreating, fmt.Sprintf("the ProxyGroup's ProxyClass %q is not yet in a ready state, waiting...", tc.pc), 1, cl, zl.Sugar())
+		expectEqual(t, fc, tc.pg)
+	}
+}
+
 func TestProxyGroupTypes(t *testing.T) {
 	pc := &tsapi.ProxyClass{
 		ObjectMeta: metav1.ObjectMeta{
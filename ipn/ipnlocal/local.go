@@ -441,6 +441,37 @@ type metrics struct {
 	// approvedRoutes is a metric that reports the number of network routes served by the local node and approved
 	// by the control server.
 	approvedRoutes *usermetric.Gauge
+
+	// wgPeers is a metric that reports the number of peers in the most recent
+	// WireGuard configuration generated from the netmap.
+	wgPeers *usermetric.Gauge
+
+	// wgSkippedPeers is a metric that reports, broken down by reason, the
+	// number of peers that were skipped, or had some of their routes skipped,
+	// when generating the most recent WireGuard configuration.
+	wgSkippedPeers *usermetric.MultiLabelMap[wgSkippedPeersLabel]
+}
+
+// wgSkippedPeersLabel is the label for the wgSkippedPeers metric.
+type wgSkippedPeersLabel struct {
+	Reason string
+}
+
+// Reasons used as the label values of the wgSkippedPeers metric.
+const (
+	wgSkippedPeersReasonNoDisco            = "no_disco"
+	wgSkippedPeersReasonExpired            = "expired"
+	wgSkippedPeersReasonUnselectedExitNode = "unselected_exit_node"
+	wgSkippedPeersReasonSubnetNotAccepted  = "subnet_not_accepted"
+)
+
+// setWGCfgStats updates the WireGuard peer metrics from st.
+func (m *metrics) setWGCfgStats(st nmcfg.Stats) {
+	m.wgPeers.Set(float64(st.Peers))
+	m.wgSkippedPeers.SetInt(wgSkippedPeersLabel{wgSkippedPeersReasonNoDisco}, int64(st.SkippedNoDisco))
+	m.wgSkippedPeers.SetInt(wgSkippedPeersLabel{wgSkippedPeersReasonExpired}, int64(st.SkippedExpired))
+	m.wgSkippedPeers.SetInt(wgSkippedPeersLabel{wgSkippedPeersReasonUnselectedExitNode}, int64(st.SkippedExitNode))
+	m.wgSkippedPeers.SetInt(wgSkippedPeersLabel{wgSkippedPeersReasonSubnetNotAccepted}, int64(st.SkippedSubnetRouter))
 }
 
 // clientGen is a func that creates a control plane client.
@@ -492,6 +523,13 @@ func NewLocalBackend(logf logger.Logf, logID logid.PublicID, sys *tsd.System, lo
 			"tailscaled_advertised_routes", "Number of advertised network routes (e.g. by a subnet router)"),
 		approvedRoutes: sys.UserMetricsRegistry().NewGauge(
 			"tailscaled_approved_routes", "Number of approved network routes (e.g. by a subnet router)"),
+		wgPeers: sys.UserMetricsRegistry().NewGauge(
+			"tailscaled_wireguard_peers", "Number of peers in the current WireGuard configuration"),
+		wgSkippedPeers: usermetric.NewMultiLabelMapWithRegistry[wgSkippedPeersLabel](
+			sys.UserMetricsRegistry(),
+			"tailscaled_wireguard_skipped_peers",
+			"gauge",
+			"Number of peers skipped, or with routes skipped, in the current WireGuard configuration, broken down by reason"),
 	}
 
 	b := &LocalBackend{
@@ -5109,11 +5147,12 @@ func (b *LocalBackend) authReconfigLocked() {
 		priv = key.NodePrivate{}
 	}
 
-	cfg, err := nmcfg.WGCfg(priv, nm, b.logf, flags, prefs.ExitNodeID())
+	cfg, stats, err := nmcfg.WGCfgWithStats(priv, nm, b.logf, flags, prefs.ExitNodeID())
 	if err != nil {
 		b.logf("wgcfg: %v", err)
 		return
 	}
+	b.metrics.setWGCfgStats(stats)
 
 	oneCGNATRoute := shouldUseOneCGNATRoute(b.logf, b.sys.NetMon.Get(), b.sys.ControlKnobs(), version.OS())
 	rcfg := b.routerConfigLocked(cfg, prefs, oneCGNATRoute)
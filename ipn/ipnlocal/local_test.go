@@ -2002,6 +2002,63 @@ func TestWhoIs(t *testing.T) {
 	}
 }
 
+func TestWGCfgMetrics(t *testing.T) {
+	b := newTestLocalBackend(t)
+	b.pm.SetPrefs((&ipn.Prefs{
+		WantRunning: true,
+		Persist:     &persist.Persist{},
+	}).View(), ipn.NetworkProfile{})
+
+	peer := func(id tailcfg.NodeID, allowedIPs ...netip.Prefix) *tailcfg.Node {
+		return &tailcfg.Node{
+			ID:         id,
+			StableID:   tailcfg.StableNodeID(fmt.Sprint(id)),
+			Key:        makeNodeKeyFromID(id),
+			DiscoKey:   makeDiscoKeyFromID(id),
+			Addresses:  allowedIPs[:1],
+			AllowedIPs: allowedIPs,
+		}
+	}
+	noDisco := peer(3, netip.MustParsePrefix("100.64.0.3/32"))
+	noDisco.DiscoKey = key.DiscoPublic{}
+	expired := peer(4, netip.MustParsePrefix("100.64.0.4/32"))
+	expired.Expired = true
+	b.setNetMapLocked(&netmap.NetworkMap{
+		SelfNode: (&tailcfg.Node{
+			ID:        1,
+			Key:       makeNodeKeyFromID(1),
+			Addresses: []netip.Prefix{netip.MustParsePrefix("100.64.0.1/32")},
+		}).View(),
+		Peers: []tailcfg.NodeView{
+			peer(2, netip.MustParsePrefix("100.64.0.2/32")).View(),
+			noDisco.View(),
+			expired.View(),
+			peer(5, netip.MustParsePrefix("100.64.0.5/32"), netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")).View(),
+			peer(6, netip.MustParsePrefix("100.64.0.6/32"), netip.MustParsePrefix("10.0.0.0/24")).View(),
+		},
+	})
+	b.authReconfig()
+
+	if got, want := b.metrics.wgPeers.String(), "3"; got != want {
+		t.Errorf("wgPeers = %s; want %s", got, want)
+	}
+	for reason, want := range map[string]string{
+		wgSkippedPeersReasonNoDisco:            "1",
+		wgSkippedPeersReasonExpired:            "1",
+		wgSkippedPeersReasonUnselectedExitNode: "1",
+		wgSkippedPeersReasonSubnetNotAccepted:  "1",
+	} {
+		v := b.metrics.wgSkippedPeers.Get(wgSkippedPeersLabel{reason})
+		if v == nil {
+			t.Errorf("wgSkippedPeers[%q] not set", reason)
+			continue
+		}
+		if got := v.String(); got != want {
+			t.Errorf("wgSkippedPeers[%q] = %s; want %s", reason, got, want)
+		}
+	}
+}
+
 func TestWireguardExitNodeDNSResolvers(t *testing.T) {
 	type tc struct {
 		name          string
@@ -23,7 +23,8 @@ func NewMultiLabelMapWithRegistry[T comparable](m *Registry, name string, promTy
 	return nil
 }
 
-func (*noopMap[T]) Add(T, int64) {}
-func (*noopMap[T]) Set(T, any)   {}
+func (*noopMap[T]) Add(T, int64)    {}
+func (*noopMap[T]) Set(T, any)      {}
+func (*noopMap[T]) SetInt(T, int64) {}
 
 func (r *Registry) Handler(any, any) {} // no-op HTTP handler
@@ -41,8 +41,34 @@ func cidrIsSubnet(node tailcfg.NodeView, cidr netip.Prefix) bool {
 	return true
 }
 
+// Stats describes the peers considered by WGCfgWithStats.
+type Stats struct {
+	// Peers is the number of peers in the returned config.
+	Peers int
+
+	// SkippedNoDisco is the number of peers omitted from the config
+	// because they offer neither DERP nor disco.
+	SkippedNoDisco int
+	// SkippedExpired is the number of peers omitted from the config
+	// because their node key has expired.
+	SkippedExpired int
+	// SkippedExitNode is the number of peers whose exit routes were
+	// omitted because they are not the selected exit node.
+	SkippedExitNode int
+	// SkippedSubnetRouter is the number of peers whose subnet routes
+	// were omitted because subnet routes are not accepted.
+	SkippedSubnetRouter int
+}
+
 // WGCfg returns the NetworkMaps's WireGuard configuration.
 func WGCfg(pk key.NodePrivate, nm *netmap.NetworkMap, logf logger.Logf, flags netmap.WGConfigFlags, exitNode tailcfg.StableNodeID) (*wgcfg.Config, error) {
+	cfg, _, err := WGCfgWithStats(pk, nm, logf, flags, exitNode)
+	return cfg, err
+}
+
+// WGCfgWithStats is like WGCfg, but also returns Stats about the peers
+// that were included in or skipped from the returned configuration.
+func WGCfgWithStats(pk key.NodePrivate, nm *netmap.NetworkMap, logf logger.Logf, flags netmap.WGConfigFlags, exitNode tailcfg.StableNodeID) (*wgcfg.Config, Stats, error) {
 	cfg := &wgcfg.Config{
 		PrivateKey: pk,
 		Addresses:  nm.GetAddresses().AsSlice(),
@@ -71,12 +97,14 @@ func WGCfg(pk key.NodePrivate, nm *netmap.NetworkMap, logf logger.Logf, flags ne
 	}
 
 	var skippedExitNode, skippedSubnetRouter, skippedExpired []tailcfg.NodeView
+	var skippedNoDisco int
 
 	for _, peer := range nm.Peers {
 		if peer.DiscoKey().IsZero() && peer.HomeDERP() == 0 && !peer.IsWireGuardOnly() {
 			// Peer predates both DERP and active discovery, we cannot
 			// communicate with it.
 			logf("[v1] wgcfg: skipped peer %s, doesn't offer DERP or disco", peer.Key().ShortString())
+			skippedNoDisco++
 			continue
 		}
 		// Skip expired peers; we'll end up failing to connect to them
@@ -94,6 +122,7 @@ func WGCfg(pk key.NodePrivate, nm *netmap.NetworkMap, logf logger.Logf, flags ne
 		cpeer := &cfg.Peers[len(cfg.Peers)-1]
 
 		didExitNodeLog := false
+		didSubnetRouterLog := false
 		cpeer.V4MasqAddr = peer.SelfNodeV4MasqAddrForThisPeer().Clone()
 		cpeer.V6MasqAddr = peer.SelfNodeV6MasqAddrForThisPeer().Clone()
 		cpeer.IsJailed = peer.IsJailed()
@@ -108,7 +137,10 @@ func WGCfg(pk key.NodePrivate, nm *netmap.NetworkMap, logf logger.Logf, flags ne
 				continue
 			} else if cidrIsSubnet(peer, allowedIP) {
 				if (flags & netmap.AllowSubnetRoutes) == 0 {
-					skippedSubnetRouter = append(skippedSubnetRouter, peer)
+					if !didSubnetRouterLog {
+						didSubnetRouterLog = true
+						skippedSubnetRouter = append(skippedSubnetRouter, peer)
+					}
 					continue
 				}
 			}
@@ -138,5 +170,12 @@ func WGCfg(pk key.NodePrivate, nm *netmap.NetworkMap, logf logger.Logf, flags ne
 	logList("did not accept subnet routes", skippedSubnetRouter)
 	logList("skipped expired peers", skippedExpired)
 
-	return cfg, nil
+	stats := Stats{
+		Peers:               len(cfg.Peers),
+		SkippedNoDisco:      skippedNoDisco,
+		SkippedExpired:      len(skippedExpired),
+		SkippedExitNode:     len(skippedExitNode),
+		SkippedSubnetRouter: len(skippedSubnetRouter),
+	}
+	return cfg, stats, nil
 }
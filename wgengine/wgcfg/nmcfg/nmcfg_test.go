@@ -0,0 +1,89 @@
+// Copyright (c) Tailscale Inc & AUTHORS
+// SPDX-License-Identifier: BSD-3-Clause
+
+package nmcfg
+
+import (
+	"net/netip"
+	"testing"
+
+	"tailscale.com/tailcfg"
+	"tailscale.com/types/key"
+	"tailscale.com/types/netmap"
+)
+
+func TestWGCfgWithStats(t *testing.T) {
+	pfx := netip.MustParsePrefix
+	peer := func(id tailcfg.NodeID, allowedIPs ...netip.Prefix) *tailcfg.Node {
+		return &tailcfg.Node{
+			ID:         id,
+			StableID:   tailcfg.StableNodeID(id.String()),
+			Key:        key.NewNode().Public(),
+			DiscoKey:   key.NewDisco().Public(),
+			Addresses:  allowedIPs[:1],
+			AllowedIPs: allowedIPs,
+		}
+	}
+
+	regular := peer(1, pfx("100.64.0.1/32"))
+	noDisco := peer(2, pfx("100.64.0.2/32"))
+	noDisco.DiscoKey = key.DiscoPublic{}
+	expired := peer(3, pfx("100.64.0.3/32"))
+	expired.Expired = true
+	exitNode := peer(4, pfx("100.64.0.4/32"), pfx("0.0.0.0/0"), pfx("::/0"))
+	selectedExitNode := peer(5, pfx("100.64.0.5/32"), pfx("0.0.0.0/0"), pfx("::/0"))
+	subnetRouter := peer(6, pfx("100.64.0.6/32"), pfx("10.0.0.0/24"), pfx("10.0.1.0/24"))
+
+	nm := &netmap.NetworkMap{
+		Peers: nodeViews(regular, noDisco, expired, exitNode, selectedExitNode, subnetRouter),
+	}
+
+	tests := []struct {
+		name  string
+		flags netmap.WGConfigFlags
+		want  Stats
+	}{
+		{
+			name: "no-subnet-routes",
+			want: Stats{
+				Peers:               4,
+				SkippedNoDisco:      1,
+				SkippedExpired:      1,
+				SkippedExitNode:     1,
+				SkippedSubnetRouter: 1,
+			},
+		},
+		{
+			name:  "allow-subnet-routes",
+			flags: netmap.AllowSubnetRoutes,
+			want: Stats{
+				Peers:           4,
+				SkippedNoDisco:  1,
+				SkippedExpired:  1,
+				SkippedExitNode: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, got, err := WGCfgWithStats(key.NewNode(), nm, t.Logf, tt.flags, selectedExitNode.StableID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("stats = %+v; want %+v", got, tt.want)
+			}
+			if len(cfg.Peers) != got.Peers {
+				t.Errorf("len(cfg.Peers) = %d; want %d", len(cfg.Peers), got.Peers)
+			}
+		})
+	}
+}
+
+func nodeViews(nodes ...*tailcfg.Node) []tailcfg.NodeView {
+	nv := make([]tailcfg.NodeView, len(nodes))
+	for i, n := range nodes {
+		nv[i] = n.View()
+	}
+	return nv
+}